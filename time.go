@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -17,38 +18,101 @@ import (
 type TrID int
 
 const (
-	// NotYet is the translation ID for the "not yet" text.
+	// NotYet is the translation ID for the "not yet" text. It is only used
+	// for the zero time, or as an explicit opt-in for future times (see
+	// WithNotYet).
 	NotYet TrID = iota
 	// JustNow is the translation ID for the "just now" text.
 	JustNow
-	// Minute is the translation ID for the "1 minute ago" text.
-	Minute
 	// Minutes is the translation ID for the "%d minutes ago" text.
 	Minutes
-	// Hour is the singular of Hours
-	Hour
 	// Hours is the translation ID for the "%d hours ago" text.
 	Hours
 	// Yesterday is the translation ID for the "yesterday" text.
 	Yesterday
 	// Days is the translation ID for the "%d days ago" text.
 	Days
-	// Week is the singular of Weeks
-	Week
-	// Weeks is the translation ID for the "`%d weeks ago" text.
+	// Weeks is the translation ID for the "%d weeks ago" text.
 	Weeks
-	// Month is the singular of Months
-	Month
 	// Months is the translation ID for the "%d months ago" text.
 	Months
-	// Year is the singular
-	Year
 	// Years is the translation ID for the "%d years ago" text.
 	Years
+	// Soon is the translation ID for the "in a moment" text.
+	Soon
+	// InMinutes is the translation ID for the "in %d minutes" text.
+	InMinutes
+	// InHours is the translation ID for the "in %d hours" text.
+	InHours
+	// Tomorrow is the translation ID for the "tomorrow" text.
+	Tomorrow
+	// InDays is the translation ID for the "in %d days" text.
+	InDays
+	// InWeeks is the translation ID for the "in %d weeks" text.
+	InWeeks
+	// InMonths is the translation ID for the "in %d months" text.
+	InMonths
+	// InYears is the translation ID for the "in %d years" text.
+	InYears
 )
 
-// Lists all translations by identifier.
-type Terms map[TrID]string
+// Deprecated: Minute, Hour, Week, Month and Year used to be distinct
+// translation IDs for the singular wording ("1 minute ago"), back when
+// Terms mapped a TrID straight to a string. Since Terms now maps a TrID
+// to its CLDR plural forms, the singular text lives in the One form of
+// the corresponding plural ID instead. These aliases only exist so code
+// still referencing the old identifiers keeps compiling; use Minutes,
+// Hours, Weeks, Months and Years instead.
+const (
+	Minute = Minutes
+	Hour   = Hours
+	Week   = Weeks
+	Month  = Months
+	Year   = Years
+)
+
+// PluralForm is a CLDR cardinal plural category, used to pick the
+// grammatically correct text for a given count.
+type PluralForm int
+
+const (
+	// Other is the default plural form. It is the only form required for
+	// languages with no special cases, and for translations that do not
+	// depend on a count (e.g. NotYet, JustNow, Yesterday).
+	Other PluralForm = iota
+	// Zero is the CLDR "zero" plural category.
+	Zero
+	// One is the CLDR "one" plural category.
+	One
+	// Two is the CLDR "two" plural category.
+	Two
+	// Few is the CLDR "few" plural category.
+	Few
+	// Many is the CLDR "many" plural category.
+	Many
+)
+
+// countable lists the translation IDs whose text varies with the CLDR
+// plural form of a count. Every other ID only needs the Other form.
+var countable = map[TrID]bool{
+	Minutes:   true,
+	Hours:     true,
+	Days:      true,
+	Weeks:     true,
+	Months:    true,
+	Years:     true,
+	InMinutes: true,
+	InHours:   true,
+	InDays:    true,
+	InWeeks:   true,
+	InMonths:  true,
+	InYears:   true,
+}
+
+// Terms lists, for each translation ID, the text to use for every CLDR
+// plural form required by its language. IDs that do not vary with a count
+// only set Other.
+type Terms map[TrID]map[PluralForm]string
 
 // Lists all translations by language code.
 type Translation map[string]Terms
@@ -56,165 +120,326 @@ type Translation map[string]Terms
 // i18n is a map of translations by language code.
 var i18n = Translation{
 	"de": {
-		NotYet:    `noch nicht`,
-		JustNow:   `im Moment`,
-		Minute:    `vor %d Minute`,
-		Minutes:   `vor %d Minuten`,
-		Hour:      `vor %d Stunde`,
-		Hours:     `vor %d Stunden`,
-		Yesterday: `gestern`,
-		Days:      `vor %d Tagen`,
-		Week:      `vor %d Woche`,
-		Weeks:     `vor %d Wochen`,
-		Month:     `vor %d Monat`,
-		Months:    `vor %d Monaten`,
-		Year:      `vor %d Jahr`,
-		Years:     `vor %d Jahren`,
+		NotYet:    plural(`noch nicht`),
+		JustNow:   plural(`im Moment`),
+		Minutes:   oneOther(`vor %d Minute`, `vor %d Minuten`),
+		Hours:     oneOther(`vor %d Stunde`, `vor %d Stunden`),
+		Yesterday: plural(`gestern`),
+		Days:      oneOther(`vor %d Tag`, `vor %d Tagen`),
+		Weeks:     oneOther(`vor %d Woche`, `vor %d Wochen`),
+		Months:    oneOther(`vor %d Monat`, `vor %d Monaten`),
+		Years:     oneOther(`vor %d Jahr`, `vor %d Jahren`),
+		Soon:      plural(`gleich`),
+		InMinutes: oneOther(`in %d Minute`, `in %d Minuten`),
+		InHours:   oneOther(`in %d Stunde`, `in %d Stunden`),
+		Tomorrow:  plural(`morgen`),
+		InDays:    oneOther(`in %d Tag`, `in %d Tagen`),
+		InWeeks:   oneOther(`in %d Woche`, `in %d Wochen`),
+		InMonths:  oneOther(`in %d Monat`, `in %d Monaten`),
+		InYears:   oneOther(`in %d Jahr`, `in %d Jahren`),
 	},
 	"en": {
-		NotYet:    `not yet`,
-		JustNow:   `just now`,
-		Minute:    `%d minute ago`,
-		Minutes:   `%d minutes ago`,
-		Hour:      `%d hour ago`,
-		Hours:     `%d hours ago`,
-		Yesterday: `yesterday`,
-		Days:      `%d days ago`,
-		Week:      `%d week ago`,
-		Weeks:     `%d weeks ago`,
-		Month:     `%d month ago`,
-		Months:    `%d months ago`,
-		Year:      `%d year ago`,
-		Years:     `%d years ago`,
+		NotYet:    plural(`not yet`),
+		JustNow:   plural(`just now`),
+		Minutes:   oneOther(`%d minute ago`, `%d minutes ago`),
+		Hours:     oneOther(`%d hour ago`, `%d hours ago`),
+		Yesterday: plural(`yesterday`),
+		Days:      oneOther(`%d day ago`, `%d days ago`),
+		Weeks:     oneOther(`%d week ago`, `%d weeks ago`),
+		Months:    oneOther(`%d month ago`, `%d months ago`),
+		Years:     oneOther(`%d year ago`, `%d years ago`),
+		Soon:      plural(`in a moment`),
+		InMinutes: oneOther(`in %d minute`, `in %d minutes`),
+		InHours:   oneOther(`in %d hour`, `in %d hours`),
+		Tomorrow:  plural(`tomorrow`),
+		InDays:    oneOther(`in %d day`, `in %d days`),
+		InWeeks:   oneOther(`in %d week`, `in %d weeks`),
+		InMonths:  oneOther(`in %d month`, `in %d months`),
+		InYears:   oneOther(`in %d year`, `in %d years`),
 	},
 	"es": {
-		NotYet:    `aún no`,
-		JustNow:   `al instante`,
-		Minute:    `hace %d minuto`,
-		Minutes:   `hace %d minutos`,
-		Hour:      `hace %d hora`,
-		Hours:     `hace %d horas`,
-		Yesterday: `ayer`,
-		Days:      `hace %d días`,
-		Week:      `hace %d semana`,
-		Weeks:     `hace %d semanas`,
-		Month:     `hace %d mes`,
-		Months:    `hace %d meses`,
-		Year:      `hace %d año`,
-		Years:     `hace %d años`,
+		NotYet:    plural(`aún no`),
+		JustNow:   plural(`al instante`),
+		Minutes:   oneOther(`hace %d minuto`, `hace %d minutos`),
+		Hours:     oneOther(`hace %d hora`, `hace %d horas`),
+		Yesterday: plural(`ayer`),
+		Days:      oneOther(`hace %d día`, `hace %d días`),
+		Weeks:     oneOther(`hace %d semana`, `hace %d semanas`),
+		Months:    oneOther(`hace %d mes`, `hace %d meses`),
+		Years:     oneOther(`hace %d año`, `hace %d años`),
+		Soon:      plural(`en un momento`),
+		InMinutes: oneOther(`en %d minuto`, `en %d minutos`),
+		InHours:   oneOther(`en %d hora`, `en %d horas`),
+		Tomorrow:  plural(`mañana`),
+		InDays:    oneOther(`en %d día`, `en %d días`),
+		InWeeks:   oneOther(`en %d semana`, `en %d semanas`),
+		InMonths:  oneOther(`en %d mes`, `en %d meses`),
+		InYears:   oneOther(`en %d año`, `en %d años`),
 	},
 	"pt": {
-		NotYet:    `ainda não`,
-		JustNow:   `agora mesmo`,
-		Minute:    `há %d minuto`,
-		Minutes:   `há %d minutos`,
-		Hour:      `há %d hora`,
-		Hours:     `há %d horas`,
-		Yesterday: `ontem`,
-		Days:      `há %d dias`,
-		Week:      `há %d semana`,
-		Weeks:     `há %d semanas`,
-		Month:     `há %d mês`,
-		Months:    `há %d meses`,
-		Year:      `há %d ano`,
-		Years:     `há %d anos`,
+		NotYet:    plural(`ainda não`),
+		JustNow:   plural(`agora mesmo`),
+		Minutes:   oneOther(`há %d minuto`, `há %d minutos`),
+		Hours:     oneOther(`há %d hora`, `há %d horas`),
+		Yesterday: plural(`ontem`),
+		Days:      oneOther(`há %d dia`, `há %d dias`),
+		Weeks:     oneOther(`há %d semana`, `há %d semanas`),
+		Months:    oneOther(`há %d mês`, `há %d meses`),
+		Years:     oneOther(`há %d ano`, `há %d anos`),
+		Soon:      plural(`em um momento`),
+		InMinutes: oneOther(`em %d minuto`, `em %d minutos`),
+		InHours:   oneOther(`em %d hora`, `em %d horas`),
+		Tomorrow:  plural(`amanhã`),
+		InDays:    oneOther(`em %d dia`, `em %d dias`),
+		InWeeks:   oneOther(`em %d semana`, `em %d semanas`),
+		InMonths:  oneOther(`em %d mês`, `em %d meses`),
+		InYears:   oneOther(`em %d ano`, `em %d anos`),
 	},
 	"ru": {
-		NotYet:    `еще нет`,
-		JustNow:   `сейчас`,
-		Minute:    `%d минуту назад`,
-		Minutes:   `%d минут назад`,
-		Hour:      `%d час назад`,
-		Hours:     `%d часов назад`,
-		Yesterday: `вчера`,
-		Days:      `%d дней назад`,
-		Week:      `%d неделю назад`,
-		Weeks:     `%d недели назад`,
-		Month:     `%d месяц назад`,
-		Months:    `%d месяца назад`,
-		Year:      `%d год назад`,
-		Years:     `%d года назад`,
+		NotYet:    plural(`еще нет`),
+		JustNow:   plural(`сейчас`),
+		Minutes:   slavic(`%d минуту назад`, `%d минуты назад`, `%d минут назад`),
+		Hours:     slavic(`%d час назад`, `%d часа назад`, `%d часов назад`),
+		Yesterday: plural(`вчера`),
+		Days:      slavic(`%d день назад`, `%d дня назад`, `%d дней назад`),
+		Weeks:     slavic(`%d неделю назад`, `%d недели назад`, `%d недель назад`),
+		Months:    slavic(`%d месяц назад`, `%d месяца назад`, `%d месяцев назад`),
+		Years:     slavic(`%d год назад`, `%d года назад`, `%d лет назад`),
+		Soon:      plural(`сейчас`),
+		InMinutes: slavic(`через %d минуту`, `через %d минуты`, `через %d минут`),
+		InHours:   slavic(`через %d час`, `через %d часа`, `через %d часов`),
+		Tomorrow:  plural(`завтра`),
+		InDays:    slavic(`через %d день`, `через %d дня`, `через %d дней`),
+		InWeeks:   slavic(`через %d неделю`, `через %d недели`, `через %d недель`),
+		InMonths:  slavic(`через %d месяц`, `через %d месяца`, `через %d месяцев`),
+		InYears:   slavic(`через %d год`, `через %d года`, `через %d лет`),
 	},
 	"fr": {
-		NotYet:    `pas encore`,
-		JustNow:   `à l'instant`,
-		Minute:    `il y a %d minute`,
-		Minutes:   `il y a %d minutes`,
-		Hour:      `il y a %d heure`,
-		Hours:     `il y a %d heures`,
-		Yesterday: `hier`,
-		Days:      `il y a %d jours`,
-		Week:      `il y a %d semaine`,
-		Weeks:     `il y a %d semaines`,
-		Month:     `il y a %d mois`,
-		Months:    `il y a %d mois`,
-		Year:      `il y a %d an`,
-		Years:     `il y a %d ans`,
+		NotYet:    plural(`pas encore`),
+		JustNow:   plural(`à l'instant`),
+		Minutes:   oneOther(`il y a %d minute`, `il y a %d minutes`),
+		Hours:     oneOther(`il y a %d heure`, `il y a %d heures`),
+		Yesterday: plural(`hier`),
+		Days:      oneOther(`il y a %d jour`, `il y a %d jours`),
+		Weeks:     oneOther(`il y a %d semaine`, `il y a %d semaines`),
+		Months:    oneOther(`il y a %d mois`, `il y a %d mois`),
+		Years:     oneOther(`il y a %d an`, `il y a %d ans`),
+		Soon:      plural(`dans un instant`),
+		InMinutes: oneOther(`dans %d minute`, `dans %d minutes`),
+		InHours:   oneOther(`dans %d heure`, `dans %d heures`),
+		Tomorrow:  plural(`demain`),
+		InDays:    oneOther(`dans %d jour`, `dans %d jours`),
+		InWeeks:   oneOther(`dans %d semaine`, `dans %d semaines`),
+		InMonths:  oneOther(`dans %d mois`, `dans %d mois`),
+		InYears:   oneOther(`dans %d an`, `dans %d ans`),
 	},
 	"it": {
-		NotYet:    `non ancora`,
-		JustNow:   `al momento`,
-		Minute:    `%d minuto fa`,
-		Minutes:   `%d minuti fa`,
-		Hour:      `%d ora fa`,
-		Hours:     `%d ore fa`,
-		Yesterday: `ieri`,
-		Days:      `da %d giorni`,
-		Week:      `da %d settimana`,
-		Weeks:     `da %d settimane`,
-		Month:     `da %d mese`,
-		Months:    `da %d mesi`,
-		Year:      `da %d anno`,
-		Years:     `da %d anni`,
+		NotYet:    plural(`non ancora`),
+		JustNow:   plural(`al momento`),
+		Minutes:   oneOther(`%d minuto fa`, `%d minuti fa`),
+		Hours:     oneOther(`%d ora fa`, `%d ore fa`),
+		Yesterday: plural(`ieri`),
+		Days:      oneOther(`da %d giorno`, `da %d giorni`),
+		Weeks:     oneOther(`da %d settimana`, `da %d settimane`),
+		Months:    oneOther(`da %d mese`, `da %d mesi`),
+		Years:     oneOther(`da %d anno`, `da %d anni`),
+		Soon:      plural(`tra un momento`),
+		InMinutes: oneOther(`tra %d minuto`, `tra %d minuti`),
+		InHours:   oneOther(`tra %d ora`, `tra %d ore`),
+		Tomorrow:  plural(`domani`),
+		InDays:    oneOther(`tra %d giorno`, `tra %d giorni`),
+		InWeeks:   oneOther(`tra %d settimana`, `tra %d settimane`),
+		InMonths:  oneOther(`tra %d mese`, `tra %d mesi`),
+		InYears:   oneOther(`tra %d anno`, `tra %d anni`),
 	},
 	"nl": {
-		NotYet:    `nog niet`,
-		JustNow:   `dit moment`,
-		Minute:    `%d minuut geleden`,
-		Minutes:   `%d minuten geleden`,
-		Hour:      `%d uur geleden`,
-		Hours:     `%d uren geleden`,
-		Yesterday: `gisteren`,
-		Days:      `%d dagen geleden`,
-		Week:      `%d weke geleden`,
-		Weeks:     `%d weken geleden`,
-		Month:     `%d maand geleden`,
-		Months:    `%d maanden geleden`,
-		Year:      `%d jaar geleden.`,
-		Years:     `%d jaar geleden.`,
+		NotYet:    plural(`nog niet`),
+		JustNow:   plural(`dit moment`),
+		Minutes:   oneOther(`%d minuut geleden`, `%d minuten geleden`),
+		Hours:     oneOther(`%d uur geleden`, `%d uren geleden`),
+		Yesterday: plural(`gisteren`),
+		Days:      oneOther(`%d dag geleden`, `%d dagen geleden`),
+		Weeks:     oneOther(`%d week geleden`, `%d weken geleden`),
+		Months:    oneOther(`%d maand geleden`, `%d maanden geleden`),
+		Years:     oneOther(`%d jaar geleden.`, `%d jaar geleden.`),
+		Soon:      plural(`zo`),
+		InMinutes: oneOther(`over %d minuut`, `over %d minuten`),
+		InHours:   oneOther(`over %d uur`, `over %d uren`),
+		Tomorrow:  plural(`morgen`),
+		InDays:    oneOther(`over %d dag`, `over %d dagen`),
+		InWeeks:   oneOther(`over %d week`, `over %d weken`),
+		InMonths:  oneOther(`over %d maand`, `over %d maanden`),
+		InYears:   oneOther(`over %d jaar`, `over %d jaar`),
 	},
 	"pl": {
-		NotYet:    `jeszcze nie`,
-		JustNow:   `w tej chwili`,
-		Minute:    `%d minutę temu`,
-		Minutes:   `%d minuty temu`,
-		Hour:      `%d godzinę temu`,
-		Hours:     `%d godziny temu`,
-		Yesterday: `wczoraj`,
-		Days:      `%d dni temu`,
-		Week:      `%d tydzień temu`,
-		Weeks:     `%d tygodnie temu`,
-		Month:     `%d miesiąc temu`,
-		Months:    `%d miesiące temu`,
-		Year:      `%d rok temu`,
-		Years:     `%d lata temu`,
+		NotYet:    plural(`jeszcze nie`),
+		JustNow:   plural(`w tej chwili`),
+		Minutes:   polish(`%d minutę temu`, `%d minuty temu`, `%d minut temu`),
+		Hours:     polish(`%d godzinę temu`, `%d godziny temu`, `%d godzin temu`),
+		Yesterday: plural(`wczoraj`),
+		Days:      polish(`%d dzień temu`, `%d dni temu`, `%d dni temu`),
+		Weeks:     polish(`%d tydzień temu`, `%d tygodnie temu`, `%d tygodni temu`),
+		Months:    polish(`%d miesiąc temu`, `%d miesiące temu`, `%d miesięcy temu`),
+		Years:     polish(`%d rok temu`, `%d lata temu`, `%d lat temu`),
+		Soon:      plural(`za chwilę`),
+		InMinutes: polish(`za %d minutę`, `za %d minuty`, `za %d minut`),
+		InHours:   polish(`za %d godzinę`, `za %d godziny`, `za %d godzin`),
+		Tomorrow:  plural(`jutro`),
+		InDays:    polish(`za %d dzień`, `za %d dni`, `za %d dni`),
+		InWeeks:   polish(`za %d tydzień`, `za %d tygodnie`, `za %d tygodni`),
+		InMonths:  polish(`za %d miesiąc`, `za %d miesiące`, `za %d miesięcy`),
+		InYears:   polish(`za %d rok`, `za %d lata`, `za %d lat`),
 	},
 	"zh": {
-		NotYet:    `未到`,
-		JustNow:   `刚刚`,
-		Minute:    `%d 分钟前`,
-		Minutes:   `%d 分钟前`,
-		Hour:      `%d 小时前`,
-		Hours:     `%d 小时前`,
-		Yesterday: `昨天`,
-		Days:      `%d 天前`,
-		Week:      `%d 周前`,
-		Weeks:     `%d 周前`,
-		Month:     `%d 个月前`,
-		Months:    `%d 个月前`,
-		Year:      `%d 年前`,
-		Years:     `%d 年前`,
+		NotYet:    plural(`未到`),
+		JustNow:   plural(`刚刚`),
+		Minutes:   oneOther(`%d 分钟前`, `%d 分钟前`),
+		Hours:     oneOther(`%d 小时前`, `%d 小时前`),
+		Yesterday: plural(`昨天`),
+		Days:      oneOther(`%d 天前`, `%d 天前`),
+		Weeks:     oneOther(`%d 周前`, `%d 周前`),
+		Months:    oneOther(`%d 个月前`, `%d 个月前`),
+		Years:     oneOther(`%d 年前`, `%d 年前`),
+		Soon:      plural(`马上`),
+		InMinutes: oneOther(`%d 分钟后`, `%d 分钟后`),
+		InHours:   oneOther(`%d 小时后`, `%d 小时后`),
+		Tomorrow:  plural(`明天`),
+		InDays:    oneOther(`%d 天后`, `%d 天后`),
+		InWeeks:   oneOther(`%d 周后`, `%d 周后`),
+		InMonths:  oneOther(`%d 个月后`, `%d 个月后`),
+		InYears:   oneOther(`%d 年后`, `%d 年后`),
 	},
+	"ar": {
+		NotYet:    plural(`ليس بعد`),
+		JustNow:   plural(`الآن`),
+		Minutes:   arabic(`منذ %d دقيقة`, `منذ %d دقيقة واحدة`, `منذ %d دقيقتين`, `منذ %d دقائق`, `منذ %d دقيقة`),
+		Hours:     arabic(`منذ %d ساعة`, `منذ %d ساعة واحدة`, `منذ %d ساعتين`, `منذ %d ساعات`, `منذ %d ساعة`),
+		Yesterday: plural(`أمس`),
+		Days:      arabic(`منذ %d يوم`, `منذ %d يوم واحد`, `منذ %d يومين`, `منذ %d أيام`, `منذ %d يومًا`),
+		Weeks:     arabic(`منذ %d أسبوع`, `منذ %d أسبوع واحد`, `منذ %d أسبوعين`, `منذ %d أسابيع`, `منذ %d أسبوعًا`),
+		Months:    arabic(`منذ %d شهر`, `منذ %d شهر واحد`, `منذ %d شهرين`, `منذ %d أشهر`, `منذ %d شهرًا`),
+		Years:     arabic(`منذ %d عام`, `منذ %d عام واحد`, `منذ %d عامين`, `منذ %d أعوام`, `منذ %d عامًا`),
+		Soon:      plural(`بعد قليل`),
+		InMinutes: arabic(`بعد %d دقيقة`, `بعد %d دقيقة واحدة`, `بعد %d دقيقتين`, `بعد %d دقائق`, `بعد %d دقيقة`),
+		InHours:   arabic(`بعد %d ساعة`, `بعد %d ساعة واحدة`, `بعد %d ساعتين`, `بعد %d ساعات`, `بعد %d ساعة`),
+		Tomorrow:  plural(`غدًا`),
+		InDays:    arabic(`بعد %d يوم`, `بعد %d يوم واحد`, `بعد %d يومين`, `بعد %d أيام`, `بعد %d يومًا`),
+		InWeeks:   arabic(`بعد %d أسبوع`, `بعد %d أسبوع واحد`, `بعد %d أسبوعين`, `بعد %d أسابيع`, `بعد %d أسبوعًا`),
+		InMonths:  arabic(`بعد %d شهر`, `بعد %d شهر واحد`, `بعد %d شهرين`, `بعد %d أشهر`, `بعد %d شهرًا`),
+		InYears:   arabic(`بعد %d عام`, `بعد %d عام واحد`, `بعد %d عامين`, `بعد %d أعوام`, `بعد %d عامًا`),
+	},
+}
+
+// monthsWide lists, for each bundled language, the full month names.
+// Index 0 is unused so that a time.Month value can index it directly.
+var monthsWide = map[string][13]string{
+	"de": {"", "Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	"en": {"", "January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	"es": {"", "enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	"pt": {"", "janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	"ru": {"", "январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+	"fr": {"", "janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	"it": {"", "gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+	"nl": {"", "januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+	"pl": {"", "styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+	"zh": {"", "一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+}
+
+// monthsGenitive lists, for ru and pl, the inflected ("format") month
+// names, as used after a day number, e.g. Russian "6 июня" rather than
+// the stand-alone nominative "6 июнь". FormatLocalized always uses this
+// table over monthsWide when the language has one, whatever the layout.
+var monthsGenitive = map[string][13]string{
+	"ru": {"", "января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+	"pl": {"", "stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+}
+
+// daysWide lists, for each bundled language, the full weekday names,
+// indexed like time.Weekday (Sunday = 0).
+var daysWide = map[string][7]string{
+	"de": {"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	"en": {"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	"es": {"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	"pt": {"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+	"ru": {"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+	"fr": {"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	"it": {"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+	"nl": {"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+	"pl": {"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+	"zh": {"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+}
+
+// FormatLocalized formats t using a compact subset of date tokens
+// (YYYY, MMMM, MMM, DD, D, dddd, HH, mm), substituting the given
+// language's month and weekday names. It falls back to English for a
+// language that has no bundled tables.
+func FormatLocalized(t time.Time, lang, layout string) string {
+	months, ok := monthsGenitive[lang]
+	if !ok {
+		months, ok = monthsWide[lang]
+	}
+	if !ok {
+		months = monthsWide["en"]
+	}
+	days, ok := daysWide[lang]
+	if !ok {
+		days = daysWide["en"]
+	}
+	month := months[int(t.Month())]
+	r := strings.NewReplacer(
+		"YYYY", strconv.Itoa(t.Year()),
+		"MMMM", month,
+		"MMM", abbreviate(month),
+		"dddd", days[int(t.Weekday())],
+		"DD", fmt.Sprintf("%02d", t.Day()),
+		"D", strconv.Itoa(t.Day()),
+		"HH", fmt.Sprintf("%02d", t.Hour()),
+		"mm", fmt.Sprintf("%02d", t.Minute()),
+	)
+
+	return r.Replace(layout)
+}
+
+// abbreviate returns the first three runes of a month name, used for the
+// MMM token when no dedicated abbreviation table is bundled.
+func abbreviate(month string) string {
+	r := []rune(month)
+	if len(r) <= 3 {
+		return month
+	}
+
+	return string(r[:3])
+}
+
+// plural builds a set of forms for a translation that does not depend
+// on a count: it only ever uses the Other form.
+func plural(s string) map[PluralForm]string {
+	return map[PluralForm]string{Other: s}
+}
+
+// oneOther builds the set of forms for a language whose plural rule only
+// distinguishes One ("n == 1") from Other (everything else).
+func oneOther(one, other string) map[PluralForm]string {
+	return map[PluralForm]string{One: one, Other: other}
+}
+
+// slavic builds the set of forms for the Russian/Ukrainian plural rule,
+// which distinguishes One, Few and Many. Other mirrors Many, as CLDR's
+// "other" category is unreachable for integer counts in these languages.
+func slavic(one, few, many string) map[PluralForm]string {
+	return map[PluralForm]string{One: one, Few: few, Many: many, Other: many}
+}
+
+// polish builds the set of forms for the Polish plural rule, which
+// distinguishes One, Few and Many. Other mirrors Many.
+func polish(one, few, many string) map[PluralForm]string {
+	return map[PluralForm]string{One: one, Few: few, Many: many, Other: many}
+}
+
+// arabic builds the set of forms for the Arabic plural rule, which
+// distinguishes Zero, One, Two, Few and Many. Other mirrors Many.
+func arabic(zero, one, two, few, many string) map[PluralForm]string {
+	return map[PluralForm]string{Zero: zero, One: one, Two: two, Few: few, Many: many, Other: many}
 }
 
 // Common errors
@@ -224,100 +449,542 @@ var (
 	ErrISOCode    = errors.New("invalid language code")
 )
 
-// AddTranslation adds the terms for the given language code.
-// It fails to do it if the language code already exists or
-// if it misses some translation IDs.
+// AddTranslation adds the terms for the given language code to the
+// package-level store. See (*Elapser).AddTranslation for the full
+// semantics.
 func AddTranslation(lang string, tr Terms) error {
+	return std.AddTranslation(lang, tr)
+}
+
+// AddTranslation adds the terms for the given language code to e's own
+// store. It fails to do it if the language code already exists or if it
+// misses any of the plural forms required by the language's plural rule.
+//
+// A regional tag (e.g. "en-GB") may register a partial Terms: any ID or
+// plural form it omits is copied from its closest registered ancestor
+// (e.g. "en"), following BCP-47 fallback. Omitting a form with no
+// registered ancestor still returns ErrIncomplete.
+func (e *Elapser) AddTranslation(lang string, tr Terms) error {
 	if lang = strings.TrimSpace(lang); lang == "" {
 		return ErrISOCode
 	}
-	if _, ok := i18n[lang]; ok {
+	if _, ok := e.i18n[lang]; ok {
 		return ErrExists
 	}
-	for k := range i18n["en"] {
-		if _, ok := tr[k]; !ok {
-			return ErrIncomplete
+	parent, hasParent := parentTranslation(e.i18n, lang)
+	required := requiredForms(lang)
+	merged := make(Terms, len(e.i18n["en"]))
+	for id := range e.i18n["en"] {
+		need := []PluralForm{Other}
+		if countable[id] {
+			need = required
+		}
+		forms := make(map[PluralForm]string, len(need))
+		for form, s := range tr[id] {
+			forms[form] = s
+		}
+		for _, form := range need {
+			if _, ok := forms[form]; ok {
+				continue
+			}
+			s, ok := parent[id][form]
+			if !hasParent || !ok {
+				return ErrIncomplete
+			}
+			forms[form] = s
 		}
+		merged[id] = forms
 	}
-	i18n[lang] = tr
+	if !e.ownsI18n {
+		e.i18n = cloneTranslation(e.i18n)
+		e.ownsI18n = true
+	}
+	e.i18n[lang] = merged
 
 	return nil
 }
 
+// parentTranslation returns the terms of the closest ancestor of lang
+// registered in store (e.g. "en" for "en-GB"), following BCP-47 fallback.
+func parentTranslation(store Translation, lang string) (Terms, bool) {
+	candidates := splitTag(lang)
+	if len(candidates) <= 1 {
+		return nil, false
+	}
+	for _, candidate := range candidates[1:] {
+		if tr, ok := store[candidate]; ok {
+			return tr, true
+		}
+	}
+
+	return nil, false
+}
+
+// splitTag breaks a BCP-47-like tag into progressively shorter
+// candidates, e.g. "pt-BR" -> ["pt-BR", "pt"] or
+// "zh-Hans-CN" -> ["zh-Hans-CN", "zh-Hans", "zh"].
+func splitTag(tag string) []string {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return nil
+	}
+	parts := strings.FieldsFunc(tag, func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	candidates := make([]string, 0, len(parts))
+	for i := len(parts); i > 0; i-- {
+		candidates = append(candidates, strings.Join(parts[:i], "-"))
+	}
+
+	return candidates
+}
+
+// ResolveLang finds the best language registered on the package-level
+// store for requested, following BCP-47 fallback: the full tag, then
+// progressively shorter prefixes (e.g. "pt-BR" -> "pt"). ok is false if
+// none of those are registered, in which case matched is "en".
+func ResolveLang(requested string) (matched string, ok bool) {
+	return std.resolveLang(requested)
+}
+
+// ResolveLang finds the best language registered in e's own store for
+// requested, mirroring the package-level ResolveLang.
+func (e *Elapser) ResolveLang(requested string) (matched string, ok bool) {
+	return e.resolveLang(requested)
+}
+
+// resolveLang implements the language resolution used by ResolveLang and
+// by an Elapser's own translation lookups.
+func resolveLang(store Translation, fallback, requested string) (string, bool) {
+	for _, candidate := range splitTag(requested) {
+		if _, ok := store[candidate]; ok {
+			return candidate, true
+		}
+		for lang := range store {
+			if strings.EqualFold(lang, candidate) {
+				return lang, true
+			}
+		}
+	}
+
+	return fallback, false
+}
+
+// baseLang returns the primary language subtag of tag (e.g. "ru" for
+// "ru-RU"), so the CLDR plural rule for a registered regional variant is
+// picked from its base language rather than defaulting to one/other.
+func baseLang(tag string) string {
+	candidates := splitTag(tag)
+	if len(candidates) == 0 {
+		return tag
+	}
+
+	return candidates[len(candidates)-1]
+}
+
+// requiredForms returns the CLDR plural forms a countable translation
+// must provide for the given language's plural rule.
+func requiredForms(lang string) []PluralForm {
+	switch baseLang(lang) {
+	case "ru", "uk":
+		return []PluralForm{One, Few, Many, Other}
+	case "pl":
+		return []PluralForm{One, Few, Many, Other}
+	case "ar":
+		return []PluralForm{Zero, One, Two, Few, Many, Other}
+	default:
+		return []PluralForm{One, Other}
+	}
+}
+
+// pluralForm resolves the CLDR cardinal plural category to use for the
+// given count in the given language, following the rules at
+// https://www.unicode.org/cldr/cldr-aux/charts/29/supplemental/language_plural_rules.html
+func pluralForm(lang string, n int) PluralForm {
+	mod10, mod100 := n%10, n%100
+	switch baseLang(lang) {
+	case "ru", "uk":
+		switch {
+		case mod10 == 1 && mod100 != 11:
+			return One
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return Few
+		case mod10 == 0 || (mod10 >= 5 && mod10 <= 9) || (mod100 >= 11 && mod100 <= 14):
+			return Many
+		default:
+			return Other
+		}
+	case "pl":
+		switch {
+		case n == 1:
+			return One
+		case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+			return Few
+		case n != 1 && ((mod10 >= 0 && mod10 <= 1) || (mod10 >= 5 && mod10 <= 9) || (mod100 >= 12 && mod100 <= 14)):
+			return Many
+		default:
+			return Other
+		}
+	case "ar":
+		switch {
+		case n == 0:
+			return Zero
+		case n == 1:
+			return One
+		case n == 2:
+			return Two
+		case mod100 >= 3 && mod100 <= 10:
+			return Few
+		case mod100 >= 11 && mod100 <= 99:
+			return Many
+		default:
+			return Other
+		}
+	default:
+		if n == 1 {
+			return One
+		}
+		return Other
+	}
+}
+
+// Thresholds lets callers redefine the boundaries used to switch between
+// units when rendering an elapsed or upcoming time.
+type Thresholds struct {
+	// JustNow is the duration below which a time is rendered as JustNow
+	// (or Soon for a future time). Defaults to one minute.
+	JustNow time.Duration
+	// DaysPerWeek is the number of days from which Days switches to
+	// Weeks. Defaults to 7.
+	DaysPerWeek int
+	// WeeksPerMonth is the number of weeks from which Weeks switches to
+	// Months. Defaults to 4. Weeks are never used from 31 days on, so any
+	// value above 5 behaves like 6.
+	WeeksPerMonth int
+	// MonthsPerYear is the number of months from which Months switches
+	// to Years. Defaults to 12. Months are never used from 365 days on, so
+	// any value above 13 behaves like 14.
+	MonthsPerYear int
+}
+
+// defaultThresholds mirrors the package's historical, hard-coded behavior.
+var defaultThresholds = Thresholds{
+	JustNow:       time.Minute,
+	DaysPerWeek:   7,
+	WeeksPerMonth: 4,
+	MonthsPerYear: 12,
+}
+
+// absolute holds the settings for an Elapser's optional absolute-date
+// fallback (see WithAbsoluteAfter).
+type absolute struct {
+	after  time.Duration
+	layout string
+}
+
+// Elapser renders elapsed (or upcoming) times using its own translations,
+// clock and unit thresholds. Use New to create one; the zero value is not
+// usable.
+type Elapser struct {
+	i18n       Translation
+	ownsI18n   bool
+	fallback   string
+	now        func() time.Time
+	thresholds Thresholds
+	absolute   *absolute
+}
+
+// Option configures an Elapser.
+type Option func(*Elapser)
+
+// WithNow overrides the clock used to compute the elapsed duration. It is
+// mainly useful in tests, to get a deterministic "now".
+func WithNow(now func() time.Time) Option {
+	return func(e *Elapser) {
+		e.now = now
+	}
+}
+
+// WithTranslations replaces the whole translation store used by the
+// Elapser, instead of the package's bundled languages. The map itself is
+// cloned, so the Elapser never adds languages to the caller's map (e.g.
+// via AddTranslation) and does not see languages the caller adds to it
+// afterwards; the Terms values are shared, not copied.
+func WithTranslations(tr Translation) Option {
+	return func(e *Elapser) {
+		e.i18n = cloneTranslation(tr)
+		e.ownsI18n = true
+	}
+}
+
+// WithTerms registers, or overrides, the terms for a single language.
+func WithTerms(lang string, tr Terms) Option {
+	return func(e *Elapser) {
+		if !e.ownsI18n {
+			e.i18n = cloneTranslation(e.i18n)
+			e.ownsI18n = true
+		}
+		e.i18n[lang] = tr
+	}
+}
+
+// WithFallbackLang sets the language used when the requested one is not
+// registered. It defaults to "en".
+func WithFallbackLang(lang string) Option {
+	return func(e *Elapser) {
+		e.fallback = lang
+	}
+}
+
+// WithThresholds overrides the unit boundaries used to pick the wording of
+// an elapsed or upcoming time. A field left at its zero value keeps the
+// package's default for that boundary, so callers can tweak a single
+// threshold (e.g. JustNow) without having to restate the others.
+func WithThresholds(th Thresholds) Option {
+	if th.JustNow == 0 {
+		th.JustNow = defaultThresholds.JustNow
+	}
+	if th.DaysPerWeek == 0 {
+		th.DaysPerWeek = defaultThresholds.DaysPerWeek
+	}
+	if th.WeeksPerMonth == 0 {
+		th.WeeksPerMonth = defaultThresholds.WeeksPerMonth
+	}
+	if th.MonthsPerYear == 0 {
+		th.MonthsPerYear = defaultThresholds.MonthsPerYear
+	}
+	return func(e *Elapser) {
+		e.thresholds = th
+	}
+}
+
+// WithAbsoluteAfter makes the Elapser fall back to a localized absolute
+// date, rendered with FormatLocalized using layout, once the elapsed (or
+// upcoming) duration exceeds after.
+func WithAbsoluteAfter(after time.Duration, layout string) Option {
+	return func(e *Elapser) {
+		e.absolute = &absolute{after: after, layout: layout}
+	}
+}
+
+// New returns an Elapser configured with the given options. By default it
+// shares the package's bundled translations, uses time.Now as its clock
+// and falls back to English, matching the historical behavior of Time
+// and LocalTime.
+func New(opts ...Option) *Elapser {
+	e := &Elapser{
+		i18n:       i18n,
+		fallback:   "en",
+		now:        time.Now,
+		thresholds: defaultThresholds,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// cloneTranslation returns a shallow copy of src, so that adding a
+// language to it does not affect the original store.
+func cloneTranslation(src Translation) Translation {
+	dst := make(Translation, len(src))
+	for lang, tr := range src {
+		dst[lang] = tr
+	}
+
+	return dst
+}
+
+// std is the package-level Elapser backing Time, LocalTime and
+// LocalTimeWith. The package-level AddTranslation registers languages on
+// it, so they affect those functions but not Elapsers created with New.
+var std = New()
+
 // Time returns in a human readable format the elapsed time
 // since the given datetime in english.
 // This methods keeps the interface of the first version of the package.
 func Time(t time.Time) string {
-	return LocalTime(t, "en")
+	return std.Time(t)
 }
 
 // LocalTime returns in a human readable format the elapsed time
 // since the given datetime using the given ISO 639-1 language code.
+// A time in the future is rendered as "in X ...". Use LocalTimeWith with
+// WithNotYet to keep the historical "not yet" behavior instead.
 func LocalTime(t time.Time, lang string) string {
-	if t.IsZero() || time.Now().Before(t) {
-		return tr(NotYet, lang)
+	return std.LocalTime(t, lang)
+}
+
+// timeConfig holds the per-call settings applied by LocalTimeWith.
+type timeConfig struct {
+	notYet bool
+}
+
+// TimeOption configures a single LocalTimeWith call.
+type TimeOption func(*timeConfig)
+
+// WithNotYet makes LocalTimeWith render the historical "not yet" text for
+// any time in the future, instead of describing how soon it will occur.
+func WithNotYet() TimeOption {
+	return func(c *timeConfig) {
+		c.notYet = true
+	}
+}
+
+// LocalTimeWith behaves like LocalTime but accepts options to tweak how
+// the result is rendered.
+func LocalTimeWith(t time.Time, lang string, opts ...TimeOption) string {
+	return std.LocalTimeWith(t, lang, opts...)
+}
+
+// Time returns in a human readable format the elapsed time
+// since the given datetime in english.
+func (e *Elapser) Time(t time.Time) string {
+	return e.LocalTime(t, "en")
+}
+
+// LocalTime returns in a human readable format the elapsed time
+// since the given datetime using the given ISO 639-1 language code.
+func (e *Elapser) LocalTime(t time.Time, lang string) string {
+	return e.LocalTimeWith(t, lang)
+}
+
+// LocalTimeWith behaves like LocalTime but accepts options to tweak how
+// the result is rendered.
+func (e *Elapser) LocalTimeWith(t time.Time, lang string, opts ...TimeOption) string {
+	var cfg timeConfig
+	for _, opt := range opts {
+		opt(&cfg)
 	}
-	diff := time.Since(t)
+	if t.IsZero() {
+		return e.term(NotYet, lang)
+	}
+	now := e.now()
+	future := now.Before(t)
+	diff := now.Sub(t)
+	if future {
+		diff = t.Sub(now)
+	}
+	if e.absolute != nil && diff > e.absolute.after {
+		return FormatLocalized(t, lang, e.absolute.layout)
+	}
+	if future {
+		if cfg.notYet {
+			return e.term(NotYet, lang)
+		}
+		return e.future(diff, lang)
+	}
+
+	return e.past(diff, lang)
+}
+
+// past renders a duration elapsed since t, e.g. "3 days ago".
+func (e *Elapser) past(diff time.Duration, lang string) string {
 	// Duration in seconds
 	s := diff.Seconds()
 	// Duration in days
 	d := int(s / 86400)
 	switch {
-	case s < 60:
-		return tr(JustNow, lang)
+	case diff < e.thresholds.JustNow:
+		return e.term(JustNow, lang)
 	case s < 3600:
 		min := int(diff.Minutes())
-		return fmt.Sprintf(tr(changeIfSing(Minutes, min), lang), min)
+		return fmt.Sprintf(e.pluralTerm(Minutes, lang, min), min)
 	case s < 86400:
 		hours := int(diff.Hours())
-		return fmt.Sprintf(tr(changeIfSing(Hours, hours), lang), hours)
+		return fmt.Sprintf(e.pluralTerm(Hours, lang, hours), hours)
 	case d == 1:
-		return tr(Yesterday, lang)
-	case d < 7:
-		return fmt.Sprintf(tr(changeIfSing(Days, d), lang), d)
+		return e.term(Yesterday, lang)
+	case d < e.thresholds.DaysPerWeek:
+		return fmt.Sprintf(e.pluralTerm(Days, lang, d), d)
 	case d < 31:
 		nbWeek := int(math.Ceil(float64(d) / 7))
-		if nbWeek < 4 {
-			return fmt.Sprintf(tr(changeIfSing(Weeks, nbWeek), lang), nbWeek)
+		if nbWeek < e.thresholds.WeeksPerMonth {
+			return fmt.Sprintf(e.pluralTerm(Weeks, lang, nbWeek), nbWeek)
 		}
 		fallthrough
 	case d < 365:
 		nbMonth := int(math.Ceil(float64(d) / 30))
-		if nbMonth < 12 {
-			return fmt.Sprintf(tr(changeIfSing(Months, nbMonth), lang), nbMonth)
+		if nbMonth < e.thresholds.MonthsPerYear {
+			return fmt.Sprintf(e.pluralTerm(Months, lang, nbMonth), nbMonth)
 		}
 		fallthrough
 	default:
 		nbYear := int(math.Ceil(float64(d) / 365))
-		return fmt.Sprintf(tr(changeIfSing(Years, nbYear), lang), nbYear)
+		return fmt.Sprintf(e.pluralTerm(Years, lang, nbYear), nbYear)
 	}
 }
 
-func tr(id TrID, lang string) string {
-	ltr, ok := i18n[lang]
-	if !ok {
-		// Uses the english language as fail over.
-		ltr = i18n["en"]
-	}
-	return ltr[id]
-}
-
-func changeIfSing(id TrID, nb int) TrID {
-	if nb != 1 {
-		return id
-	}
-	switch id {
-	case Minutes:
-		return Minute
-	case Hours:
-		return Hour
-	case Months:
-		return Month
-	case Weeks:
-		return Week
-	case Years:
-		return Year
+// future renders a duration remaining until t, e.g. "in 3 days".
+func (e *Elapser) future(diff time.Duration, lang string) string {
+	// Duration in seconds
+	s := diff.Seconds()
+	// Duration in days
+	d := int(s / 86400)
+	switch {
+	case diff < e.thresholds.JustNow:
+		return e.term(Soon, lang)
+	case s < 3600:
+		min := int(diff.Minutes())
+		return fmt.Sprintf(e.pluralTerm(InMinutes, lang, min), min)
+	case s < 86400:
+		hours := int(diff.Hours())
+		return fmt.Sprintf(e.pluralTerm(InHours, lang, hours), hours)
+	case d == 1:
+		return e.term(Tomorrow, lang)
+	case d < e.thresholds.DaysPerWeek:
+		return fmt.Sprintf(e.pluralTerm(InDays, lang, d), d)
+	case d < 31:
+		nbWeek := int(math.Ceil(float64(d) / 7))
+		if nbWeek < e.thresholds.WeeksPerMonth {
+			return fmt.Sprintf(e.pluralTerm(InWeeks, lang, nbWeek), nbWeek)
+		}
+		fallthrough
+	case d < 365:
+		nbMonth := int(math.Ceil(float64(d) / 30))
+		if nbMonth < e.thresholds.MonthsPerYear {
+			return fmt.Sprintf(e.pluralTerm(InMonths, lang, nbMonth), nbMonth)
+		}
+		fallthrough
 	default:
-		return id
+		nbYear := int(math.Ceil(float64(d) / 365))
+		return fmt.Sprintf(e.pluralTerm(InYears, lang, nbYear), nbYear)
 	}
 }
+
+// term returns the text for the given translation ID and language, using
+// its Other form. It is meant for IDs that do not depend on a count.
+func (e *Elapser) term(id TrID, lang string) string {
+	return e.text(id, lang, Other)
+}
+
+// pluralTerm returns the text for the given translation ID and language,
+// picking the CLDR plural form that matches n.
+func (e *Elapser) pluralTerm(id TrID, lang string, n int) string {
+	resolved, _ := e.resolveLang(lang)
+	return e.text(id, lang, pluralForm(resolved, n))
+}
+
+// text looks up the translation for id in lang, resolving lang with
+// BCP-47 fallback (e.g. "en-GB" -> "en") before falling back to the
+// Elapser's fallback language and then to the Other form when the
+// requested plural form is missing.
+func (e *Elapser) text(id TrID, lang string, form PluralForm) string {
+	resolved, _ := e.resolveLang(lang)
+	ltr := e.i18n[resolved]
+	forms, ok := ltr[id]
+	if !ok {
+		forms = e.i18n[e.fallback][id]
+	}
+	if s, ok := forms[form]; ok {
+		return s
+	}
+	return forms[Other]
+}
+
+// resolveLang finds the best language registered in e's store for
+// requested, following BCP-47 fallback. See ResolveLang.
+func (e *Elapser) resolveLang(requested string) (matched string, ok bool) {
+	return resolveLang(e.i18n, e.fallback, requested)
+}
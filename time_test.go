@@ -18,23 +18,35 @@ func TestAddTranslation(t *testing.T) {
 	}{
 		{lang: "", err: ErrISOCode},
 		{lang: "fr", err: ErrExists},
-		{lang: "ru", tr: Terms{Yesterday: "euh"}, err: ErrIncomplete},
-		{lang: "en-gb", tr: Terms{
-			NotYet:    `not yet`,
-			JustNow:   `just now`,
-			Minute:    `1 minute ago`,
-			Minutes:   `%d minutes ago`,
-			Hour:      `1 hour ago`,
-			Hours:     `%d hours ago`,
-			Yesterday: `yesterday`,
-			Days:      `%d days ago`,
-			Week:      `1 weeks ago`,
-			Weeks:     `%d weeks ago`,
-			Month:     `1 months ago`,
-			Months:    `%d months ago`,
-			Year:      `1 years ago`,
-			Years:     `%d years ago`,
+		{lang: "cy", tr: Terms{Yesterday: plural("ddoe")}, err: ErrIncomplete},
+		{lang: "cy", tr: Terms{
+			NotYet:    plural(`ddim eto`),
+			JustNow:   plural(`newydd nawr`),
+			Minutes:   oneOther(`%d munud yn ôl`, `%d munud yn ôl`),
+			Hours:     oneOther(`%d awr yn ôl`, `%d awr yn ôl`),
+			Yesterday: plural(`ddoe`),
+			Days:      oneOther(`%d diwrnod yn ôl`, `%d diwrnod yn ôl`),
+			Weeks:     oneOther(`%d wythnos yn ôl`, `%d wythnos yn ôl`),
+			Months:    oneOther(`%d mis yn ôl`, `%d mis yn ôl`),
+			Years:     oneOther(`%d flwyddyn yn ôl`, `%d flwyddyn yn ôl`),
+			Soon:      plural(`mewn eiliad`),
+			InMinutes: oneOther(`mewn %d munud`, `mewn %d munud`),
+			InHours:   oneOther(`mewn %d awr`, `mewn %d awr`),
+			Tomorrow:  plural(`yfory`),
+			InDays:    oneOther(`mewn %d diwrnod`, `mewn %d diwrnod`),
+			InWeeks:   oneOther(`mewn %d wythnos`, `mewn %d wythnos`),
+			InMonths:  oneOther(`mewn %d mis`, `mewn %d mis`),
+			InYears:   oneOther(`mewn %d flwyddyn`, `mewn %d flwyddyn`),
 		}},
+		// en-GB has a registered parent ("en") to inherit the rest from.
+		// Weeks is overridden with a value distinct from "en". Only the
+		// registration result is checked here; TestAddTranslationInherited
+		// checks the rendered override on an Elapser of its own.
+		{lang: "en-GB", tr: Terms{
+			Weeks: oneOther(`%d week back`, `%d weeks back`),
+		}, err: nil},
+		// xx-YY has no registered parent, so a partial Terms is incomplete.
+		{lang: "xx-YY", tr: Terms{Yesterday: plural("yesterday")}, err: ErrIncomplete},
 	}
 	for i, tt := range dt {
 		if err := AddTranslation(tt.lang, tt.tr); err != tt.err {
@@ -43,39 +55,188 @@ func TestAddTranslation(t *testing.T) {
 	}
 }
 
+func TestAddTranslationInherited(t *testing.T) {
+	// Uses its own Elapser rather than the package-level AddTranslation,
+	// so it does not depend on TestAddTranslation having registered
+	// "en-GB" first: it passes standalone, in any order, under -shuffle.
+	e := New()
+	if err := e.AddTranslation("en-GB", Terms{
+		Weeks: oneOther(`%d week back`, `%d weeks back`),
+	}); err != nil {
+		t.Fatalf("failed to register en-GB: %v", err)
+	}
+	// Weeks was overridden to a value distinct from "en", so this
+	// actually distinguishes the override from a fallback to the parent.
+	if out := e.LocalTime(time.Now().Add(-time.Hour*24*14), "en-GB"); out != "2 weeks back" {
+		t.Errorf("content mismatch: exp=%q got=%q", "2 weeks back", out)
+	}
+	// Hours was not overridden, so it must have been copied from "en".
+	if out := e.LocalTime(time.Now().Add(-time.Hour), "en-GB"); out != "1 hour ago" {
+		t.Errorf("content mismatch: exp=%q got=%q", "1 hour ago", out)
+	}
+}
+
+func TestResolveLang(t *testing.T) {
+	// Uses its own Elapser, registering "en-GB" on it directly, rather
+	// than relying on TestAddTranslation's package-level registration:
+	// two tests calling AddTranslation("en-GB", ...) on the shared store
+	// would otherwise collide under -shuffle, whichever ran second
+	// getting ErrExists.
+	e := New()
+	if err := e.AddTranslation("en-GB", Terms{
+		Weeks: oneOther(`%d week back`, `%d weeks back`),
+	}); err != nil {
+		t.Fatalf("failed to register en-GB: %v", err)
+	}
+	var dt = []struct {
+		requested string
+		matched   string
+		ok        bool
+	}{
+		{"fr", "fr", true},
+		{"en-GB", "en-GB", true},
+		{"pt-BR", "pt", true},
+		{"zh-Hans-CN", "zh", true},
+		{"xx", "en", false},
+	}
+	for i, tt := range dt {
+		matched, ok := e.ResolveLang(tt.requested)
+		if matched != tt.matched || ok != tt.ok {
+			t.Errorf("%d. content mismatch for %q: exp=(%q, %v) got=(%q, %v)", i, tt.requested, tt.matched, tt.ok, matched, ok)
+		}
+	}
+}
+
+func TestLocalTimeBCP47Fallback(t *testing.T) {
+	// "pt-BR" is not registered but "pt" is, so it should resolve to it
+	// rather than falling back to English.
+	if out := LocalTime(time.Now().Add(-time.Hour), "pt-BR"); out == "1 hour ago" {
+		t.Errorf("expected %q to resolve to the Portuguese translation, got English output", "pt-BR")
+	}
+}
+
+func TestPluralForm(t *testing.T) {
+	var dt = []struct {
+		lang string
+		n    int
+		out  PluralForm
+	}{
+		{"en", 1, One},
+		{"en", 2, Other},
+		{"ru", 1, One},
+		{"ru", 2, Few},
+		{"ru", 5, Many},
+		{"ru", 11, Many},
+		{"ru", 21, One},
+		// A registered regional variant must still use its base
+		// language's plural rule, not fall back to one/other.
+		{"ru-RU", 2, Few},
+		{"pl", 1, One},
+		{"pl", 2, Few},
+		{"pl", 5, Many},
+		{"pl", 12, Many},
+		{"ar", 0, Zero},
+		{"ar", 1, One},
+		{"ar", 2, Two},
+		{"ar", 5, Few},
+		{"ar", 15, Many},
+		{"ar", 100, Other},
+	}
+	for i, tt := range dt {
+		if out := pluralForm(tt.lang, tt.n); out != tt.out {
+			t.Errorf("%d. content mismatch for %q/%d: exp=%v got=%v", i, tt.lang, tt.n, tt.out, out)
+		}
+	}
+}
+
 func TestLocalTime(t *testing.T) {
 	var dt = []struct {
 		in  time.Time
 		out string
 	}{
-		{time.Time{}, "not yet"},
-		{time.Now().Add(time.Hour), "not yet"},
-		{time.Now(), "just now"},
-		{time.Now().Add(-time.Minute), "1 minute ago"},
-		{time.Now().Add(-time.Minute * 40), "40 minutes ago"},
-		{time.Now().Add(-time.Hour), "1 hour ago"},
-		{time.Now().Add(-time.Hour * 3), "3 hours ago"},
-		{time.Now().Add(-time.Hour * 32), "yesterday"},
-		{time.Now().Add(-time.Hour * 24 * 6), "6 days ago"},
-		{time.Now().Add(-(time.Hour * 24 * 6) - 2*time.Hour), "6 days ago"},
-		{time.Now().Add(-time.Hour * 24 * 3), "3 days ago"},
-		{time.Now().Add(-time.Hour * 24 * 7), "1 week ago"},
-		{time.Now().Add(-time.Hour * 24 * 14), "2 weeks ago"},
+		{time.Time{}, "еще нет"},
+		{time.Now(), "сейчас"},
+		{time.Now().Add(-time.Minute), "1 минуту назад"},
+		{time.Now().Add(-time.Minute * 2), "2 минуты назад"},
+		{time.Now().Add(-time.Minute * 5), "5 минут назад"},
+		{time.Now().Add(-time.Hour), "1 час назад"},
+		{time.Now().Add(-time.Hour * 3), "3 часа назад"},
+		{time.Now().Add(-time.Hour * 32), "вчера"},
+		{time.Now().Add(-time.Hour * 24 * 6), "6 дней назад"},
+		{time.Now().Add(-(time.Hour * 24 * 6) - 2*time.Hour), "6 дней назад"},
+		{time.Now().Add(-time.Hour * 24 * 3), "3 дня назад"},
+		{time.Now().Add(-time.Hour * 24 * 7), "1 неделю назад"},
+		{time.Now().Add(-time.Hour * 24 * 14), "2 недели назад"},
 		// 4 weeks == 1 month
-		{time.Now().Add(-time.Hour * 24 * 28), "1 month ago"},
-		{time.Now().Add(-time.Hour * 24 * 60), "2 months ago"},
+		{time.Now().Add(-time.Hour * 24 * 28), "1 месяц назад"},
+		{time.Now().Add(-time.Hour * 24 * 60), "2 месяца назад"},
 		// 12 months == 1 year
-		{time.Now().Add(-time.Hour * 24 * 360), "1 year ago"},
-		{time.Now().Add(-time.Hour * 24 * 365 * 3), "3 years ago"},
+		{time.Now().Add(-time.Hour * 24 * 360), "1 год назад"},
+		{time.Now().Add(-time.Hour * 24 * 365 * 3), "3 года назад"},
 	}
 	for i, tt := range dt {
-		// Requests an unknown language.
 		if out := LocalTime(tt.in, "ru"); out != tt.out {
 			t.Errorf("%d. content mismatch for %v: exp=%q got=%q", i, tt.in, tt.out, out)
 		}
 	}
 }
 
+func TestLocalTimeFuture(t *testing.T) {
+	var dt = []struct {
+		in  time.Time
+		out string
+	}{
+		{time.Now().Add(time.Minute*30 + time.Second), "через 30 минут"},
+		{time.Now().Add(time.Hour + time.Second), "через 1 час"},
+		{time.Now().Add(time.Hour * 32), "завтра"},
+		{time.Now().Add(time.Hour*24*3 + time.Minute), "через 3 дня"},
+	}
+	for i, tt := range dt {
+		if out := LocalTime(tt.in, "ru"); out != tt.out {
+			t.Errorf("%d. content mismatch for %v: exp=%q got=%q", i, tt.in, tt.out, out)
+		}
+	}
+}
+
+func TestLocalTimeWithNotYet(t *testing.T) {
+	in := time.Now().Add(time.Hour)
+	if out := LocalTimeWith(in, "en", WithNotYet()); out != "not yet" {
+		t.Errorf("content mismatch: exp=%q got=%q", "not yet", out)
+	}
+}
+
+func TestLocalTimePolish(t *testing.T) {
+	var dt = []struct {
+		in  time.Time
+		out string
+	}{
+		{time.Now().Add(-time.Minute), "1 minutę temu"},
+		{time.Now().Add(-time.Minute * 2), "2 minuty temu"},
+		{time.Now().Add(-time.Minute * 5), "5 minut temu"},
+	}
+	for i, tt := range dt {
+		if out := LocalTime(tt.in, "pl"); out != tt.out {
+			t.Errorf("%d. content mismatch for %v: exp=%q got=%q", i, tt.in, tt.out, out)
+		}
+	}
+}
+
+func TestLocalTimeArabic(t *testing.T) {
+	var dt = []struct {
+		in  time.Time
+		out string
+	}{
+		{time.Now().Add(-time.Minute), "منذ 1 دقيقة واحدة"},
+		{time.Now().Add(-time.Minute * 2), "منذ 2 دقيقتين"},
+		{time.Now().Add(-time.Minute * 5), "منذ 5 دقائق"},
+	}
+	for i, tt := range dt {
+		if out := LocalTime(tt.in, "ar"); out != tt.out {
+			t.Errorf("%d. content mismatch for %v: exp=%q got=%q", i, tt.in, tt.out, out)
+		}
+	}
+}
+
 func ExampleTime() {
 	t := time.Now().Add(-time.Hour)
 	fmt.Println(Time(t))
@@ -83,13 +244,127 @@ func ExampleTime() {
 	t = time.Now().Add(-time.Hour * 24 * 3)
 	fmt.Println(Time(t))
 
-	t, _ = time.Parse("2006-02-01", "2049-08-19")
+	t = time.Now().Add(time.Hour*24*3 + time.Minute)
 	fmt.Println(Time(t))
 
 	t = time.Now().Add(-time.Hour * 24 * 3)
 	fmt.Println(LocalTime(t, "fr"))
 	// Output: 1 hour ago
 	// 3 days ago
-	// not yet
+	// in 3 days
 	// il y a 3 jours
 }
+
+func ExampleLocalTimeWith() {
+	t := time.Now().Add(time.Hour*24*3 + time.Minute)
+	fmt.Println(LocalTimeWith(t, "en"))
+	fmt.Println(LocalTimeWith(t, "en", WithNotYet()))
+	// Output: in 3 days
+	// not yet
+}
+
+func TestElapserWithNow(t *testing.T) {
+	now := time.Date(2018, time.June, 6, 12, 0, 0, 0, time.UTC)
+	e := New(WithNow(func() time.Time { return now }))
+	var dt = []struct {
+		in  time.Time
+		out string
+	}{
+		{now.Add(-time.Minute * 5), "5 minutes ago"},
+		{now.Add(-time.Hour * 24 * 3), "3 days ago"},
+		{now.Add(time.Hour * 24 * 3), "in 3 days"},
+	}
+	for i, tt := range dt {
+		if out := e.LocalTime(tt.in, "en"); out != tt.out {
+			t.Errorf("%d. content mismatch for %v: exp=%q got=%q", i, tt.in, tt.out, out)
+		}
+	}
+}
+
+func TestElapserWithThresholds(t *testing.T) {
+	now := time.Date(2018, time.June, 6, 12, 0, 0, 0, time.UTC)
+	e := New(WithNow(func() time.Time { return now }), WithThresholds(Thresholds{
+		JustNow:       time.Minute * 2,
+		DaysPerWeek:   7,
+		WeeksPerMonth: 4,
+		MonthsPerYear: 12,
+	}))
+	if out := e.LocalTime(now.Add(-time.Second*90), "en"); out != "just now" {
+		t.Errorf("content mismatch: exp=%q got=%q", "just now", out)
+	}
+}
+
+func TestElapserWithThresholdsPartial(t *testing.T) {
+	// Setting only JustNow must not zero out the other boundaries: a
+	// zero DaysPerWeek/WeeksPerMonth/MonthsPerYear would make every
+	// duration of two days or more fall through to Years.
+	now := time.Date(2018, time.June, 6, 12, 0, 0, 0, time.UTC)
+	e := New(WithNow(func() time.Time { return now }), WithThresholds(Thresholds{
+		JustNow: time.Minute * 2,
+	}))
+	if out := e.LocalTime(now.Add(-time.Hour*24*3), "en"); out != "3 days ago" {
+		t.Errorf("content mismatch: exp=%q got=%q", "3 days ago", out)
+	}
+}
+
+func TestElapserWithTerms(t *testing.T) {
+	e := New()
+	e2 := New(WithTerms("xx", Terms{
+		NotYet:    plural(`nope`),
+		JustNow:   plural(`right now`),
+		Minutes:   oneOther(`%d min ago`, `%d mins ago`),
+		Hours:     oneOther(`%d hr ago`, `%d hrs ago`),
+		Yesterday: plural(`yesterday`),
+		Days:      oneOther(`%d day ago`, `%d days ago`),
+		Weeks:     oneOther(`%d wk ago`, `%d wks ago`),
+		Months:    oneOther(`%d mo ago`, `%d mos ago`),
+		Years:     oneOther(`%d yr ago`, `%d yrs ago`),
+		Soon:      plural(`soon`),
+		InMinutes: oneOther(`in %d min`, `in %d mins`),
+		InHours:   oneOther(`in %d hr`, `in %d hrs`),
+		Tomorrow:  plural(`tomorrow`),
+		InDays:    oneOther(`in %d day`, `in %d days`),
+		InWeeks:   oneOther(`in %d wk`, `in %d wks`),
+		InMonths:  oneOther(`in %d mo`, `in %d mos`),
+		InYears:   oneOther(`in %d yr`, `in %d yrs`),
+	}))
+	// e's default store must not have been mutated by e2's WithTerms.
+	if out := e.LocalTime(time.Now(), "xx"); out != "just now" {
+		t.Errorf("expected e to fall back to english for an unknown language, got %q", out)
+	}
+	if out := e2.LocalTime(time.Now().Add(-time.Minute*2), "xx"); out != "2 mins ago" {
+		t.Errorf("content mismatch: exp=%q got=%q", "2 mins ago", out)
+	}
+}
+
+func TestFormatLocalized(t *testing.T) {
+	d := time.Date(2018, time.June, 6, 9, 30, 0, 0, time.UTC)
+	var dt = []struct {
+		lang, layout, out string
+	}{
+		{"en", "D MMMM YYYY", "6 June 2018"},
+		{"fr", "D MMMM YYYY", "6 juin 2018"},
+		{"ru", "D MMMM YYYY", "6 июня 2018"},
+		{"en", "dddd D MMM YYYY, HH:mm", "Wednesday 6 Jun 2018, 09:30"},
+		{"zh", "YYYY MMMM DD", "2018 六月 06"},
+	}
+	for i, tt := range dt {
+		if out := FormatLocalized(d, tt.lang, tt.layout); out != tt.out {
+			t.Errorf("%d. content mismatch: exp=%q got=%q", i, tt.out, out)
+		}
+	}
+}
+
+func TestElapserWithAbsoluteAfter(t *testing.T) {
+	now := time.Date(2018, time.June, 6, 12, 0, 0, 0, time.UTC)
+	e := New(WithNow(func() time.Time { return now }), WithAbsoluteAfter(time.Hour*24*365, "D MMMM YYYY"))
+	if out := e.LocalTime(now.AddDate(0, 0, -30), "en"); out != "1 month ago" {
+		t.Errorf("content mismatch: exp=%q got=%q", "1 month ago", out)
+	}
+	if out := e.LocalTime(now.AddDate(-3, 0, 0), "en"); out != "6 June 2015" {
+		t.Errorf("content mismatch: exp=%q got=%q", "6 June 2015", out)
+	}
+	if out := e.LocalTime(now.AddDate(3, 0, 0), "fr"); out != "6 juin 2021" {
+		t.Errorf("content mismatch: exp=%q got=%q", "6 juin 2021", out)
+	}
+}